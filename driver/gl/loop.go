@@ -15,8 +15,17 @@ type funcData struct {
 	done chan bool
 }
 
+// maxBatchSize caps how many queued funcs runFuncBatch will execute in a
+// single drain before returning control to the outer select, so a
+// pathological burst cannot starve window events and FPS ticks.
+const maxBatchSize = 64
+
+// maxBatchWait caps how long runFuncBatch keeps pulling from funcQueue
+// once it has started a batch, for the same reason as maxBatchSize.
+const maxBatchWait = 2 * time.Millisecond
+
 // channel for queuing functions on the main thread
-var funcQueue = make(chan funcData)
+var funcQueue = make(chan funcData, 256)
 var runFlag = false
 var runMutex = &sync.Mutex{}
 
@@ -51,6 +60,37 @@ func runOnMainAsync(f func()) {
 	}()
 }
 
+// runFuncBatch executes first, then keeps non-blockingly pulling and
+// running additional queued funcs - up to maxBatchSize of them, or
+// until maxBatchWait has elapsed - before returning. This collapses a
+// burst of background work (texture uploads, buffer rebuilds from
+// SetText, ProgressBar.SetValue, etc.) submitted from worker goroutines
+// into a single pass through the main loop instead of trickling through
+// at the FPS tick rate.
+func runFuncBatch(first funcData) {
+	runQueued(first)
+
+	deadline := time.Now().Add(maxBatchWait)
+	for n := 1; n < maxBatchSize; n++ {
+		select {
+		case f := <-funcQueue:
+			runQueued(f)
+			if time.Now().After(deadline) {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+func runQueued(f funcData) {
+	f.f()
+	if f.done != nil {
+		f.done <- true
+	}
+}
+
 func (d *gLDriver) runGL() {
 	fps := time.NewTicker(time.Second / 60)
 	runMutex.Lock()
@@ -67,10 +107,7 @@ func (d *gLDriver) runGL() {
 			glfw.Terminate()
 			return
 		case f := <-funcQueue:
-			f.f()
-			if f.done != nil {
-				f.done <- true
-			}
+			runFuncBatch(f)
 		case <-settingsChange:
 			clearFontCache()
 		case <-fps.C:
@@ -111,6 +148,8 @@ func (d *gLDriver) runGL() {
 }
 
 func (d *gLDriver) freeDirtyTextures(canvas *glCanvas) {
+	var dirty []uint32
+
 	for {
 		select {
 		case object := <-canvas.refreshQueue:
@@ -119,13 +158,23 @@ func (d *gLDriver) freeDirtyTextures(canvas *glCanvas) {
 				if ok {
 					var texture uint32 = tObj.(uint32)
 					if texture > 0 {
-						gl.DeleteTextures(1, &texture)
+						dirty = append(dirty, texture)
 						textures.Delete(obj)
 					}
 				}
 			}
 			canvas.walkObjects(object, fyne.NewPos(0, 0), freeWalked)
 		default:
+			if len(dirty) == 0 {
+				return
+			}
+
+			// freeDirtyTextures already runs on the main thread, so
+			// delete every texture for this canvas in one GL call
+			// here rather than queuing each one through runOnMain,
+			// which would deadlock waiting for this same goroutine to
+			// drain funcQueue.
+			gl.DeleteTextures(int32(len(dirty)), &dirty[0])
 			return
 		}
 	}
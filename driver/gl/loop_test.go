@@ -0,0 +1,50 @@
+package gl
+
+import (
+	"sync"
+	"testing"
+)
+
+// BenchmarkRunOnMainBatch queues 1000 no-op funcs per iteration to
+// measure the throughput of runFuncBatch draining funcQueue in bursts,
+// versus executing one func per select iteration.
+func BenchmarkRunOnMainBatch(b *testing.B) {
+	runMutex.Lock()
+	runFlag = true
+	runMutex.Unlock()
+	defer func() {
+		runMutex.Lock()
+		runFlag = false
+		runMutex.Unlock()
+	}()
+
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case f := <-funcQueue:
+				runFuncBatch(f)
+			case <-stop:
+				return
+			}
+		}
+	}()
+	defer close(stop)
+
+	noop := func() {}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		// queue from separate goroutines so funcs pile up in funcQueue
+		// and runFuncBatch has a burst to drain
+		var wg sync.WaitGroup
+		wg.Add(1000)
+		for i := 0; i < 1000; i++ {
+			go func() {
+				runOnMain(noop)
+				wg.Done()
+			}()
+		}
+		wg.Wait()
+	}
+}
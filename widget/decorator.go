@@ -0,0 +1,268 @@
+package widget
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/theme"
+)
+
+// DecorPosition identifies where a ProgressDecorator's text should be
+// rendered relative to the bar it is attached to.
+type DecorPosition int
+
+const (
+	// LeadingLabel renders the decorator text to the left of the bar.
+	LeadingLabel DecorPosition = iota
+	// TrailingLabel renders the decorator text to the right of the bar.
+	TrailingLabel
+	// Overlay renders the decorator text centred on top of the bar.
+	Overlay
+)
+
+// Stats carries the values a ProgressDecorator needs in order to render
+// its text. It is rebuilt and passed to every attached decorator each
+// time the owning progress bar refreshes. Indeterminate bars report
+// Total as 0, which decorators such as NewPercentage treat as "unknown".
+type Stats struct {
+	Current    float64
+	Total      float64
+	StartTime  time.Time
+	LastUpdate time.Time
+}
+
+// ProgressDecorator renders additional text alongside a ProgressBar or
+// InfProgressBar, such as a percentage, elapsed time or byte counter.
+type ProgressDecorator interface {
+	// Decorate returns the text to display for the given Stats.
+	Decorate(stat Stats) string
+	// Position reports where the decorator's text should be placed.
+	Position() DecorPosition
+}
+
+// funcDecorator is a ProgressDecorator built from a plain function, used
+// by the constructors below so each built-in decorator is a one-liner.
+type funcDecorator struct {
+	pos     DecorPosition
+	decorFn func(Stats) string
+}
+
+func (d *funcDecorator) Decorate(stat Stats) string {
+	return d.decorFn(stat)
+}
+
+func (d *funcDecorator) Position() DecorPosition {
+	return d.pos
+}
+
+func newFuncDecorator(pos DecorPosition, fn func(Stats) string) ProgressDecorator {
+	return &funcDecorator{pos: pos, decorFn: fn}
+}
+
+// NewPercentage creates a decorator that renders the current completion
+// percentage, e.g. "42%". An indeterminate bar (Total == 0) renders "--%".
+func NewPercentage() ProgressDecorator {
+	return newFuncDecorator(TrailingLabel, func(stat Stats) string {
+		if stat.Total <= 0 {
+			return "--%"
+		}
+		return fmt.Sprintf("%.0f%%", stat.Current/stat.Total*100)
+	})
+}
+
+// NewOverlayPercentage creates a decorator that renders the current
+// completion percentage centred on top of the bar, e.g. "42%", instead of
+// beside it. An indeterminate bar (Total == 0) renders "--%".
+func NewOverlayPercentage() ProgressDecorator {
+	return newFuncDecorator(Overlay, func(stat Stats) string {
+		if stat.Total <= 0 {
+			return "--%"
+		}
+		return fmt.Sprintf("%.0f%%", stat.Current/stat.Total*100)
+	})
+}
+
+// NewElapsed creates a decorator that renders the time elapsed since the
+// bar started, e.g. "1m23s".
+func NewElapsed() ProgressDecorator {
+	return newFuncDecorator(LeadingLabel, func(stat Stats) string {
+		return formatDuration(stat.LastUpdate.Sub(stat.StartTime))
+	})
+}
+
+// NewETA creates a decorator that renders a naive estimate of the time
+// remaining, based on the average throughput since the bar started, e.g.
+// "ETA 1m23s". It renders "ETA --" until Total is known or no progress
+// has been made yet. See NewETADecorator for the smoothed equivalent
+// built on ProgressBar's rate estimator.
+func NewETA() ProgressDecorator {
+	return newFuncDecorator(TrailingLabel, func(stat Stats) string {
+		elapsed := stat.LastUpdate.Sub(stat.StartTime).Seconds()
+		if stat.Total <= 0 || stat.Current <= 0 || elapsed <= 0 {
+			return "ETA --"
+		}
+		rate := stat.Current / elapsed
+		if rate <= 0 {
+			return "ETA --"
+		}
+		remain := time.Duration((stat.Total - stat.Current) / rate * float64(time.Second))
+		return "ETA " + formatDuration(remain)
+	})
+}
+
+// NewCountersNoUnit creates a decorator that renders the current and
+// total counters using format, e.g. NewCountersNoUnit("%d/%d") renders
+// "3/10".
+func NewCountersNoUnit(format string) ProgressDecorator {
+	return newFuncDecorator(TrailingLabel, func(stat Stats) string {
+		return fmt.Sprintf(format, int64(stat.Current), int64(stat.Total))
+	})
+}
+
+// NewName creates a decorator that renders a fixed, unchanging label,
+// typically used to identify a row in a ProgressGroup.
+func NewName(name string) ProgressDecorator {
+	return newFuncDecorator(LeadingLabel, func(stat Stats) string {
+		return name
+	})
+}
+
+// NewSpeed creates a decorator that renders the average throughput since
+// the bar started, e.g. NewSpeed("MB/s", "%.1f %s") renders "4.2 MB/s".
+func NewSpeed(unit, format string) ProgressDecorator {
+	return newFuncDecorator(TrailingLabel, func(stat Stats) string {
+		elapsed := stat.LastUpdate.Sub(stat.StartTime).Seconds()
+		if elapsed <= 0 {
+			return fmt.Sprintf(format, 0.0, unit)
+		}
+		return fmt.Sprintf(format, stat.Current/elapsed, unit)
+	})
+}
+
+// NewETADecorator creates a decorator that renders bar's smoothed ETA
+// estimate (see ProgressBar.SetRateEstimator), e.g. "ETA 1m23s". It
+// renders "ETA --" until the estimator has seen enough samples.
+func NewETADecorator(bar *ProgressBar) ProgressDecorator {
+	return newFuncDecorator(TrailingLabel, func(stat Stats) string {
+		eta := bar.ETA()
+		if eta < 0 {
+			return "ETA --"
+		}
+		return "ETA " + formatDuration(eta)
+	})
+}
+
+// NewRateDecorator creates a decorator that renders bar's smoothed
+// units-per-second throughput (see ProgressBar.SetRateEstimator),
+// formatted with unit, e.g. "4.2 MB/s".
+func NewRateDecorator(bar *ProgressBar, unit string) ProgressDecorator {
+	return newFuncDecorator(TrailingLabel, func(stat Stats) string {
+		return fmt.Sprintf("%.1f %s/s", bar.Rate(), unit)
+	})
+}
+
+// formatDuration renders d the way decorators display elapsed times and
+// ETAs, e.g. "1m23s" or "45s".
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	d = d.Round(time.Second)
+	m := d / time.Minute
+	d -= m * time.Minute
+	s := d / time.Second
+	if m > 0 {
+		return fmt.Sprintf("%dm%ds", m, s)
+	}
+	return fmt.Sprintf("%ds", s)
+}
+
+// decoratorSet groups the canvas objects used to render a bar's
+// attached decorators, split by position, and is shared by the
+// ProgressBar and InfProgressBar renderers.
+type decoratorSet struct {
+	decorators []ProgressDecorator
+
+	leading  []*canvas.Text
+	trailing []*canvas.Text
+	overlay  []*canvas.Text
+}
+
+// set rebuilds the canvas.Text objects backing list, one per decorator,
+// grouped by DecorPosition. It returns every object created so the
+// caller can add them to its renderer's object list.
+func (d *decoratorSet) set(list []ProgressDecorator) []fyne.CanvasObject {
+	d.decorators = list
+	d.leading = nil
+	d.trailing = nil
+	d.overlay = nil
+
+	var objects []fyne.CanvasObject
+	for _, dec := range list {
+		text := canvas.NewText("", theme.TextColor())
+		objects = append(objects, text)
+
+		switch dec.Position() {
+		case LeadingLabel:
+			d.leading = append(d.leading, text)
+		case TrailingLabel:
+			d.trailing = append(d.trailing, text)
+		default:
+			d.overlay = append(d.overlay, text)
+		}
+	}
+	return objects
+}
+
+// update refreshes the text of every decorator's canvas.Text for stat.
+func (d *decoratorSet) update(stat Stats) {
+	li, ti, oi := 0, 0, 0
+	for _, dec := range d.decorators {
+		str := dec.Decorate(stat)
+		switch dec.Position() {
+		case LeadingLabel:
+			d.leading[li].Text = str
+			li++
+		case TrailingLabel:
+			d.trailing[ti].Text = str
+			ti++
+		default:
+			d.overlay[oi].Text = str
+			oi++
+		}
+	}
+}
+
+// layout positions the leading decorators to the left of the widget, the
+// trailing decorators to the right, and centres the overlay decorators
+// over the widget itself, regardless of where the animated bar rectangle
+// currently sits.
+func (d *decoratorSet) layout(size fyne.Size) {
+	x := theme.Padding()
+	for _, text := range d.leading {
+		min := text.MinSize()
+		text.Move(fyne.NewPos(x, (size.Height-min.Height)/2))
+		text.Resize(min)
+		x += min.Width + theme.Padding()
+	}
+
+	x = size.Width - theme.Padding()
+	for i := len(d.trailing) - 1; i >= 0; i-- {
+		text := d.trailing[i]
+		min := text.MinSize()
+		x -= min.Width
+		text.Move(fyne.NewPos(x, (size.Height-min.Height)/2))
+		text.Resize(min)
+		x -= theme.Padding()
+	}
+
+	for _, text := range d.overlay {
+		min := text.MinSize()
+		cx := (size.Width - min.Width) / 2
+		cy := (size.Height - min.Height) / 2
+		text.Move(fyne.NewPos(cx, cy))
+		text.Resize(min)
+	}
+}
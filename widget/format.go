@@ -0,0 +1,52 @@
+package widget
+
+import "fmt"
+
+var iecBytePrefixes = []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+var siBytePrefixes = []string{"kB", "MB", "GB", "TB", "PB", "EB"}
+var siCountPrefixes = []string{"k", "M", "G", "T", "P", "E"}
+
+// FormatBytesIEC formats n as a human readable byte count using the IEC
+// (1024-based) prefix table, e.g. 12910541 -> "12.3 MiB".
+func FormatBytesIEC(n int64) string {
+	return formatBytes(n, 1024, iecBytePrefixes)
+}
+
+// FormatBytesSI formats n as a human readable byte count using the SI
+// (1000-based) prefix table, e.g. 12910541 -> "12.9 MB".
+func FormatBytesSI(n int64) string {
+	return formatBytes(n, 1000, siBytePrefixes)
+}
+
+func formatBytes(n int64, base float64, prefixes []string) string {
+	if float64(n) < base {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value, prefix := scale(n, base, prefixes)
+	return fmt.Sprintf("%.1f %s", value, prefix)
+}
+
+// formatCountSI formats n using SI count suffixes with no space, e.g.
+// 12910541 -> "12.9M", for non-byte quantities.
+func formatCountSI(n int64) string {
+	if float64(n) < 1000 {
+		return fmt.Sprintf("%d", n)
+	}
+
+	value, prefix := scale(n, 1000, siCountPrefixes)
+	return fmt.Sprintf("%.1f%s", value, prefix)
+}
+
+// scale divides n down by base until it fits within [1, base), or until
+// prefixes is exhausted, and returns the scaled value with its prefix.
+func scale(n int64, base float64, prefixes []string) (float64, string) {
+	value := float64(n)
+	i := -1
+	for value >= base && i < len(prefixes)-1 {
+		value /= base
+		i++
+	}
+
+	return value, prefixes[i]
+}
@@ -0,0 +1,29 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBytesIEC(t *testing.T) {
+	assert.Equal(t, "512 B", FormatBytesIEC(512))
+	assert.Equal(t, "1.0 KiB", FormatBytesIEC(1024))
+	assert.Equal(t, "12.3 MiB", FormatBytesIEC(12910541))
+}
+
+func TestFormatBytesSI(t *testing.T) {
+	assert.Equal(t, "512 B", FormatBytesSI(512))
+	assert.Equal(t, "1.0 kB", FormatBytesSI(1000))
+	assert.Equal(t, "12.9 MB", FormatBytesSI(12910541))
+}
+
+func TestProgressBar_SetUnit(t *testing.T) {
+	bar := NewProgressBar()
+	bar.Max = 50331648 // 48 MiB
+	bar.SetUnit(UnitBytesIEC)
+	bar.SetValue(12910541) // ~12.3 MiB
+
+	render := Renderer(bar).(*progressRenderer)
+	assert.Equal(t, "12.3 MiB / 48.0 MiB (26%)", render.label.Text)
+}
@@ -14,6 +14,7 @@ const infiniteRefreshRate = 50 * time.Millisecond
 type infProgressRenderer struct {
 	objects  []fyne.CanvasObject
 	bar      *canvas.Rectangle
+	decor    decoratorSet
 	progress *InfProgressBar
 }
 
@@ -75,6 +76,7 @@ func (p *infProgressRenderer) updateBar() {
 func (p *infProgressRenderer) Layout(size fyne.Size) {
 	// set height of progress bar
 	p.updateBar()
+	p.decor.layout(size)
 }
 
 // ApplyTheme is called when the progress bar may need to update it's look
@@ -88,8 +90,17 @@ func (p *infProgressRenderer) BackgroundColor() color.Color {
 	return theme.ButtonColor()
 }
 
-func (p *infProgressRenderer) Refresh() {
+// refreshQuiet recomputes the bar position and decorator text without
+// triggering this widget's own canvas repaint; see InfProgressBar.refreshQuiet.
+func (p *infProgressRenderer) refreshQuiet() {
 	p.updateBar()
+	// an infinite bar never completes, so decorators always see Total == 0
+	p.decor.update(Stats{StartTime: p.progress.startTime, LastUpdate: time.Now()})
+	p.decor.layout(p.progress.Size())
+}
+
+func (p *infProgressRenderer) Refresh() {
+	p.refreshQuiet()
 	canvas.Refresh(p.progress)
 }
 
@@ -102,7 +113,15 @@ func (p *infProgressRenderer) Objects() []fyne.CanvasObject {
 type InfProgressBar struct {
 	baseWidget
 
-	ticker *time.Ticker
+	ticker    *time.Ticker
+	startTime time.Time
+
+	decorators []ProgressDecorator
+
+	// managed is set by ProgressGroup on rows it owns, so Start/Stop
+	// become no-ops and the row can only ever be driven by the group's
+	// shared ticker, never by a second goroutine of its own.
+	managed bool
 }
 
 // Resize sets a new size for a widget.
@@ -134,15 +153,50 @@ func (p *InfProgressBar) Hide() {
 	p.hide(p)
 }
 
-// Start the infinite progress bar background thread to update it continuously
+// Start the infinite progress bar background thread to update it
+// continuously. It has no effect on a row owned by a ProgressGroup,
+// which is driven by the group's shared ticker instead.
 func (p *InfProgressBar) Start() {
+	if p.managed {
+		return
+	}
 	if p.ticker == nil {
+		p.startTime = time.Now()
 		go p.infiniteProgressLoop()
 	}
 }
 
-// Stop the infinite progress goroutine and sets value to the Max
+// SetDecorators attaches an ordered list of ProgressDecorators that are
+// rendered alongside the bar each time it refreshes. Since an infinite
+// bar has no known Total, decorators such as NewPercentage render their
+// "unknown" form (e.g. "--%"). Passing no arguments clears any
+// previously attached decorators.
+func (p *InfProgressBar) SetDecorators(decorators ...ProgressDecorator) {
+	p.decorators = decorators
+
+	if Renderer(p) != nil {
+		r := Renderer(p).(*infProgressRenderer)
+		objects := r.decor.set(decorators)
+		r.objects = append([]fyne.CanvasObject{r.bar}, objects...)
+		r.Refresh()
+	}
+}
+
+// refreshQuiet updates this bar's renderer without triggering its own
+// canvas repaint. ProgressGroup uses this to drive every child bar from
+// a single shared ticker and fold all of their repaints into one
+// canvas.Refresh call per frame, instead of each bar animating off its
+// own goroutine.
+func (p *InfProgressBar) refreshQuiet() {
+	Renderer(p).(*infProgressRenderer).refreshQuiet()
+}
+
+// Stop the infinite progress goroutine. It has no effect on a row owned
+// by a ProgressGroup, see Start.
 func (p *InfProgressBar) Stop() {
+	if p.managed {
+		return
+	}
 	if p.ticker != nil {
 		p.ticker.Stop()
 		p.ticker = nil
@@ -165,7 +219,11 @@ func (p *InfProgressBar) infiniteProgressLoop() {
 func (p *InfProgressBar) CreateRenderer() fyne.WidgetRenderer {
 	bar := canvas.NewRectangle(theme.PrimaryColor())
 
-	return &infProgressRenderer{[]fyne.CanvasObject{bar}, bar, p}
+	r := &infProgressRenderer{objects: []fyne.CanvasObject{bar}, bar: bar, progress: p}
+	if len(p.decorators) > 0 {
+		r.objects = append(r.objects, r.decor.set(p.decorators)...)
+	}
+	return r
 }
 
 // NewInfiniteProgressBar creates a new progress bar widget that loops indefinitely from 0% -> 100%
@@ -0,0 +1,345 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/theme"
+)
+
+// rateSampleFloor is the minimum elapsed time between SetValue calls
+// that is used to update the rate estimate; samples closer together
+// than this are skipped to avoid jitter from bursts of tiny updates.
+const rateSampleFloor = 10 * time.Millisecond
+
+// defaultRateAlpha is the smoothing factor used by the rate estimator
+// until SetRateEstimator is called.
+const defaultRateAlpha = 0.7
+
+// ProgressUnit selects how a ProgressBar formats its label.
+type ProgressUnit int
+
+const (
+	// UnitNone renders the plain completion percentage, e.g. "42%". This is the default.
+	UnitNone ProgressUnit = iota
+	// UnitCountSI renders Value and Max using SI count suffixes, e.g. "1.2k / 4.8k (25%)".
+	UnitCountSI
+	// UnitBytesIEC renders Value and Max as IEC (1024-based) byte counts, e.g. "12.3 MiB / 48.0 MiB (26%)".
+	UnitBytesIEC
+	// UnitBytesSI renders Value and Max as SI (1000-based) byte counts, e.g. "12.3 MB / 48.0 MB (26%)".
+	UnitBytesSI
+)
+
+type progressRenderer struct {
+	objects []fyne.CanvasObject
+
+	bar   *canvas.Rectangle
+	label *canvas.Text
+	decor decoratorSet
+
+	progress *ProgressBar
+}
+
+// MinSize calculates the minimum size of a progress bar.
+func (p *progressRenderer) MinSize() fyne.Size {
+	text := textMinSize(p.progress.sampleLabelText(), theme.TextSize(), fyne.TextStyle{})
+
+	return fyne.NewSize(text.Width+theme.Padding()*4, text.Height+theme.Padding()*2)
+}
+
+func (p *progressRenderer) updateBar() {
+	size := p.progress.Size()
+
+	p.progress.rateLock.Lock()
+	value, min, max := p.progress.Value, p.progress.Min, p.progress.Max
+	p.progress.rateLock.Unlock()
+
+	delta := max - min
+	ratio := 0.0
+	if delta > 0 {
+		ratio = (value - min) / delta
+	}
+
+	barWidth := int(float64(size.Width) * ratio)
+	p.bar.Resize(fyne.NewSize(barWidth, size.Height))
+	p.bar.Move(fyne.NewPos(0, 0))
+
+	p.label.Text = p.progress.formatLabel(value, max, ratio)
+	p.label.Resize(size)
+}
+
+// Layout the components of the progress bar widget
+func (p *progressRenderer) Layout(size fyne.Size) {
+	p.updateBar()
+	p.decor.layout(size)
+}
+
+// ApplyTheme is called when the progress bar may need to update it's look
+func (p *progressRenderer) ApplyTheme() {
+	p.bar.FillColor = theme.PrimaryColor()
+	p.label.Color = theme.TextColor()
+
+	p.Refresh()
+}
+
+func (p *progressRenderer) BackgroundColor() color.Color {
+	return theme.ButtonColor()
+}
+
+// refreshQuiet recomputes the bar width and decorator text without
+// triggering this widget's own canvas repaint; see ProgressBar.refreshQuiet.
+func (p *progressRenderer) refreshQuiet() {
+	p.updateBar()
+	p.decor.update(p.progress.stats())
+	p.decor.layout(p.progress.Size())
+}
+
+func (p *progressRenderer) Refresh() {
+	p.refreshQuiet()
+	canvas.Refresh(p.progress)
+}
+
+func (p *progressRenderer) Objects() []fyne.CanvasObject {
+	return p.objects
+}
+
+func (p *progressRenderer) Destroy() {
+}
+
+// ProgressBar widget creates a horizontal panel that indicates progress
+// towards completion of a task as a filled bar, labelled with the
+// current completion percentage.
+type ProgressBar struct {
+	baseWidget
+
+	Min, Max, Value float64
+
+	startTime  time.Time
+	lastUpdate time.Time
+
+	decorators []ProgressDecorator
+	unit       ProgressUnit
+
+	rateLock     sync.Mutex
+	rateAlpha    float64
+	rateAlphaSet bool
+	rate         float64
+	lastValue    float64
+}
+
+// Resize sets a new size for a widget.
+// Note this should not be used if the widget is being managed by a Layout within a Container.
+func (p *ProgressBar) Resize(size fyne.Size) {
+	p.resize(size, p)
+}
+
+// Move the widget to a new position, relative to it's parent.
+// Note this should not be used if the widget is being managed by a Layout within a Container.
+func (p *ProgressBar) Move(pos fyne.Position) {
+	p.move(pos, p)
+}
+
+// MinSize returns the smallest size this widget can shrink to
+func (p *ProgressBar) MinSize() fyne.Size {
+	return p.minSize(p)
+}
+
+// Show this widget, if it was previously hidden
+func (p *ProgressBar) Show() {
+	p.show(p)
+}
+
+// Hide this widget, if it was previously visible
+func (p *ProgressBar) Hide() {
+	p.hide(p)
+}
+
+// SetValue changes the current value of this progress bar, updates the
+// label to match and triggers a redraw. It also feeds the value into
+// this bar's rate estimator, see SetRateEstimator.
+func (p *ProgressBar) SetValue(v float64) {
+	now := time.Now()
+
+	p.rateLock.Lock()
+	switch {
+	case v < p.lastValue:
+		// the value went backwards (e.g. a retried operation), the
+		// existing estimate no longer means anything
+		p.rate = 0
+	case v >= p.Max:
+		p.rate = 0
+	case !p.lastUpdate.IsZero():
+		if dt := now.Sub(p.lastUpdate); dt >= rateSampleFloor {
+			alpha := defaultRateAlpha
+			if p.rateAlphaSet {
+				alpha = p.rateAlpha
+			}
+			sample := (v - p.lastValue) / dt.Seconds()
+			p.rate = alpha*p.rate + (1-alpha)*sample
+		}
+	}
+	p.lastValue = v
+	p.Value = v
+	p.lastUpdate = now
+	p.rateLock.Unlock()
+
+	Renderer(p).Refresh()
+}
+
+// SetRateEstimator sets the smoothing factor used by the exponential
+// moving average that backs ETA and Rate. Higher alpha weighs history
+// more heavily and produces a smoother, slower-to-react estimate; lower
+// alpha follows the most recent samples more closely, with 0 following
+// the latest sample exactly. Without a call to SetRateEstimator, SetValue
+// uses defaultRateAlpha.
+func (p *ProgressBar) SetRateEstimator(alpha float64) {
+	p.rateLock.Lock()
+	p.rateAlpha = alpha
+	p.rateAlphaSet = true
+	p.rateLock.Unlock()
+}
+
+// Rate returns the current smoothed units-per-second throughput
+// estimate, as fed by SetValue.
+func (p *ProgressBar) Rate() float64 {
+	p.rateLock.Lock()
+	defer p.rateLock.Unlock()
+
+	return p.rate
+}
+
+// ETA returns a smoothed estimate of the time remaining until Value
+// reaches Max, based on Rate. It returns -1 when the rate is not yet
+// known, for example before the first SetValue call.
+func (p *ProgressBar) ETA() time.Duration {
+	p.rateLock.Lock()
+	rate := p.rate
+	value := p.Value
+	max := p.Max
+	p.rateLock.Unlock()
+
+	if rate <= 0 {
+		return -1
+	}
+
+	remain := max - value
+	return time.Duration(remain / rate * float64(time.Second))
+}
+
+// refreshQuiet updates this bar's renderer without triggering its own
+// canvas repaint. ProgressGroup uses this to drive every child bar from
+// a single shared ticker and fold all of their repaints into one
+// canvas.Refresh call per frame.
+func (p *ProgressBar) refreshQuiet() {
+	Renderer(p).(*progressRenderer).refreshQuiet()
+}
+
+// SetDecorators attaches an ordered list of ProgressDecorators that are
+// rendered alongside the bar each time it refreshes. Passing no
+// arguments clears any previously attached decorators.
+func (p *ProgressBar) SetDecorators(decorators ...ProgressDecorator) {
+	p.decorators = decorators
+
+	if Renderer(p) != nil {
+		r := Renderer(p).(*progressRenderer)
+		objects := r.decor.set(decorators)
+		r.objects = append([]fyne.CanvasObject{r.bar, r.label}, objects...)
+		r.Refresh()
+	}
+}
+
+// SetUnit changes how this bar's label is formatted. The default,
+// UnitNone, renders a plain percentage such as "42%"; the other units
+// additionally render Value and Max, humanised as bytes or SI counts,
+// e.g. "12.3 MiB / 48.0 MiB (26%)".
+func (p *ProgressBar) SetUnit(unit ProgressUnit) {
+	p.unit = unit
+
+	if Renderer(p) != nil {
+		Renderer(p).Refresh()
+	}
+}
+
+// formatLabel renders this bar's label text for the given value, max and
+// completion ratio (Value-Min over Max-Min), honouring SetUnit. value and
+// max are passed in, rather than read from p, so the caller can take a
+// single consistent snapshot under rateLock.
+func (p *ProgressBar) formatLabel(value, max, ratio float64) string {
+	switch p.unit {
+	case UnitCountSI:
+		return fmt.Sprintf("%s / %s (%.0f%%)", formatCountSI(int64(value)), formatCountSI(int64(max)), ratio*100)
+	case UnitBytesIEC:
+		return fmt.Sprintf("%s / %s (%.0f%%)", FormatBytesIEC(int64(value)), FormatBytesIEC(int64(max)), ratio*100)
+	case UnitBytesSI:
+		return fmt.Sprintf("%s / %s (%.0f%%)", FormatBytesSI(int64(value)), FormatBytesSI(int64(max)), ratio*100)
+	default:
+		return fmt.Sprintf("%.0f%%", ratio*100)
+	}
+}
+
+// sampleLabelText renders the widest label this bar's current unit is
+// expected to produce, using Max for both sides of the ratio, so
+// progressRenderer.MinSize reserves enough width for the real labels
+// SetUnit's non-default modes render (e.g. "12.3 MiB / 48.0 MiB (100%)").
+func (p *ProgressBar) sampleLabelText() string {
+	p.rateLock.Lock()
+	max := p.Max
+	p.rateLock.Unlock()
+
+	switch p.unit {
+	case UnitCountSI:
+		return fmt.Sprintf("%s / %s (100%%)", formatCountSI(int64(max)), formatCountSI(int64(max)))
+	case UnitBytesIEC:
+		return fmt.Sprintf("%s / %s (100%%)", FormatBytesIEC(int64(max)), FormatBytesIEC(int64(max)))
+	case UnitBytesSI:
+		return fmt.Sprintf("%s / %s (100%%)", FormatBytesSI(int64(max)), FormatBytesSI(int64(max)))
+	default:
+		return "100%"
+	}
+}
+
+// stats builds the Stats snapshot passed to this bar's decorators.
+func (p *ProgressBar) stats() Stats {
+	p.rateLock.Lock()
+	value, max, last := p.Value, p.Max, p.lastUpdate
+	p.rateLock.Unlock()
+
+	if p.startTime.IsZero() {
+		p.startTime = time.Now()
+	}
+	if last.IsZero() {
+		last = p.startTime
+	}
+
+	return Stats{
+		Current:    value,
+		Total:      max,
+		StartTime:  p.startTime,
+		LastUpdate: last,
+	}
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to it's renderer
+func (p *ProgressBar) CreateRenderer() fyne.WidgetRenderer {
+	bar := canvas.NewRectangle(theme.PrimaryColor())
+	label := canvas.NewText("0%", theme.TextColor())
+	label.Alignment = fyne.TextAlignCenter
+
+	r := &progressRenderer{objects: []fyne.CanvasObject{bar, label}, bar: bar, label: label, progress: p}
+	if len(p.decorators) > 0 {
+		r.objects = append(r.objects, r.decor.set(p.decorators)...)
+	}
+	return r
+}
+
+// NewProgressBar creates a new progress bar widget.
+// The default Min and Max are 0 and 1, Value starts at 0.
+func NewProgressBar() *ProgressBar {
+	p := &ProgressBar{Min: 0, Max: 1}
+	Renderer(p).Layout(p.MinSize())
+	return p
+}
@@ -0,0 +1,89 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar_Creation(t *testing.T) {
+	bar := NewProgressBar()
+	assert.Equal(t, 0.0, bar.Value)
+	assert.Equal(t, 1.0, bar.Max)
+}
+
+func TestProgressBar_SetValue(t *testing.T) {
+	bar := NewProgressBar()
+	bar.SetValue(0.25)
+
+	render := Renderer(bar).(*progressRenderer)
+	assert.Equal(t, "25%", render.label.Text)
+}
+
+func TestProgressBar_ETA_Unknown(t *testing.T) {
+	bar := NewProgressBar()
+
+	// no samples yet, so the rate (and therefore ETA) is unknown
+	assert.Equal(t, time.Duration(-1), bar.ETA())
+}
+
+func TestProgressBar_Rate_ResetsOnValueGoingBackwards(t *testing.T) {
+	bar := NewProgressBar()
+	bar.Max = 100
+
+	bar.SetValue(10)
+	time.Sleep(15 * time.Millisecond)
+	bar.SetValue(20)
+	assert.True(t, bar.Rate() > 0)
+
+	bar.SetValue(5) // value moved backwards
+	assert.Equal(t, 0.0, bar.Rate())
+}
+
+func TestProgressBar_Rate_ClampsAtMax(t *testing.T) {
+	bar := NewProgressBar()
+	bar.Max = 100
+
+	bar.SetValue(10)
+	time.Sleep(15 * time.Millisecond)
+	bar.SetValue(100)
+	assert.Equal(t, 0.0, bar.Rate())
+}
+
+func TestProgressBar_SetRateEstimator_ZeroAlphaFollowsLatestSample(t *testing.T) {
+	bar := NewProgressBar()
+	bar.Max = 100
+	bar.SetRateEstimator(0)
+
+	bar.SetValue(10)
+	time.Sleep(15 * time.Millisecond)
+	bar.SetValue(20) // rate = 10/dt
+
+	rate := bar.Rate()
+	time.Sleep(15 * time.Millisecond)
+	bar.SetValue(25) // alpha 0 means this sample alone sets the new rate
+
+	assert.NotEqual(t, rate, bar.Rate())
+}
+
+func TestProgressBar_SetDecorators(t *testing.T) {
+	bar := NewProgressBar()
+	bar.Max = 100
+	bar.SetDecorators(NewPercentage())
+	bar.SetValue(50)
+
+	render := Renderer(bar).(*progressRenderer)
+	assert.Len(t, render.decor.trailing, 1)
+	assert.Equal(t, "50%", render.decor.trailing[0].Text)
+}
+
+func TestProgressBar_Resize(t *testing.T) {
+	bar := NewProgressBar()
+	bar.SetValue(0.5)
+	bar.Resize(fyne.NewSize(100, 10))
+
+	render := Renderer(bar).(*progressRenderer)
+	assert.Equal(t, 50, render.bar.Size().Width)
+}
@@ -0,0 +1,346 @@
+package widget
+
+import (
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/theme"
+)
+
+// groupRefreshRate is shared by every row in a ProgressGroup, instead of
+// each row ticking independently like a standalone InfProgressBar.
+const groupRefreshRate = infiniteRefreshRate
+
+// quietRefresher is implemented by the widgets a ProgressGroup can host.
+// ProgressGroup drives these from its own shared ticker rather than
+// letting each one animate off its own goroutine.
+type quietRefresher interface {
+	refreshQuiet()
+}
+
+type groupRow struct {
+	name   string
+	widget fyne.Widget
+}
+
+type groupRenderer struct {
+	objects []fyne.CanvasObject
+	group   *ProgressGroup
+}
+
+// rebuild recomputes the flattened object list from the group's
+// aggregate bar and its current rows, in order.
+func (r *groupRenderer) rebuild() {
+	rows := r.group.snapshotRows()
+
+	objects := []fyne.CanvasObject{r.group.aggregate}
+	for _, row := range rows {
+		objects = append(objects, row.widget)
+	}
+	r.objects = objects
+}
+
+// MinSize calculates the minimum size of the group: every row stacked
+// vertically, at the width of the widest row.
+func (r *groupRenderer) MinSize() fyne.Size {
+	width, height := 0, 0
+	for i, obj := range r.objects {
+		min := obj.MinSize()
+		width = fyne.Max(width, min.Width)
+		height += min.Height
+		if i > 0 {
+			height += theme.Padding()
+		}
+	}
+
+	return fyne.NewSize(width, height)
+}
+
+// Layout stacks the aggregate bar and every row vertically, full width.
+func (r *groupRenderer) Layout(size fyne.Size) {
+	y := 0
+	for _, obj := range r.objects {
+		height := obj.MinSize().Height
+		obj.Move(fyne.NewPos(0, y))
+		obj.Resize(fyne.NewSize(size.Width, height))
+		y += height + theme.Padding()
+	}
+}
+
+// ApplyTheme is called when the group may need to update it's look
+func (r *groupRenderer) ApplyTheme() {
+	r.Refresh()
+}
+
+func (r *groupRenderer) BackgroundColor() color.Color {
+	return color.Transparent
+}
+
+func (r *groupRenderer) Refresh() {
+	r.rebuild()
+	r.Layout(r.group.Size())
+	canvas.Refresh(r.group)
+}
+
+func (r *groupRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *groupRenderer) Destroy() {
+}
+
+// ProgressGroup is a container that owns a number of child ProgressBar
+// and InfProgressBar rows, each labelled by name, plus an aggregate bar
+// at the top showing overall completion across the determinate
+// children. Rows are added with AddBar and AddInfinite only; there is no
+// method that adds a Spinner row. All rows are driven from a single shared
+// ticker rather than one goroutine per bar, so it scales to bulk
+// download or build UIs that track many operations at once.
+type ProgressGroup struct {
+	baseWidget
+
+	// rowLock guards rows: AddBar/AddInfinite/Remove mutate it from the
+	// caller's goroutine while groupLoop reads it from the group's own
+	// ticker goroutine.
+	rowLock   sync.Mutex
+	rows      []*groupRow
+	aggregate *ProgressBar
+
+	dropOnComplete bool
+	popOnComplete  bool
+
+	ticker *time.Ticker
+}
+
+// snapshotRows returns a copy of rows, safe to range over without holding
+// rowLock.
+func (g *ProgressGroup) snapshotRows() []*groupRow {
+	g.rowLock.Lock()
+	defer g.rowLock.Unlock()
+
+	rows := make([]*groupRow, len(g.rows))
+	copy(rows, g.rows)
+	return rows
+}
+
+// Resize sets a new size for a widget.
+// Note this should not be used if the widget is being managed by a Layout within a Container.
+func (g *ProgressGroup) Resize(size fyne.Size) {
+	g.resize(size, g)
+}
+
+// Move the widget to a new position, relative to it's parent.
+// Note this should not be used if the widget is being managed by a Layout within a Container.
+func (g *ProgressGroup) Move(pos fyne.Position) {
+	g.move(pos, g)
+}
+
+// MinSize returns the smallest size this widget can shrink to
+func (g *ProgressGroup) MinSize() fyne.Size {
+	return g.minSize(g)
+}
+
+// Show this widget, if it was previously hidden. This starts the
+// shared ticker driving every row.
+func (g *ProgressGroup) Show() {
+	g.Start()
+	g.show(g)
+}
+
+// Hide this widget, if it was previously visible. This stops the
+// shared ticker driving every row.
+func (g *ProgressGroup) Hide() {
+	g.Stop()
+	g.hide(g)
+}
+
+// AddBar adds a new determinate ProgressBar row named name, with the
+// given Max, and returns it so the caller can drive it with SetValue.
+func (g *ProgressGroup) AddBar(name string, max float64) *ProgressBar {
+	bar := NewProgressBar()
+	bar.Max = max
+	bar.SetDecorators(NewName(name), NewPercentage())
+
+	g.addRow(name, bar)
+	return bar
+}
+
+// AddInfinite adds a new InfProgressBar row named name and returns it.
+// The row is driven by the group's shared ticker, so Start/Stop on the
+// returned bar have no effect while it remains in the group.
+func (g *ProgressGroup) AddInfinite(name string) *InfProgressBar {
+	bar := NewInfiniteProgressBar()
+	bar.startTime = time.Now()
+	bar.managed = true
+	bar.SetDecorators(NewName(name))
+
+	g.addRow(name, bar)
+	return bar
+}
+
+func (g *ProgressGroup) addRow(name string, w fyne.Widget) {
+	g.rowLock.Lock()
+	g.rows = append(g.rows, &groupRow{name: name, widget: w})
+	g.rowLock.Unlock()
+
+	if Renderer(g) != nil {
+		r := Renderer(g).(*groupRenderer)
+		r.Refresh()
+	}
+}
+
+// Remove removes b from the group, if it is a row.
+func (g *ProgressGroup) Remove(b fyne.Widget) {
+	g.rowLock.Lock()
+	for i, row := range g.rows {
+		if row.widget == b {
+			g.rows = append(g.rows[:i], g.rows[i+1:]...)
+			if inf, ok := b.(*InfProgressBar); ok {
+				// hand Start/Stop back to the bar now it has left the group
+				inf.managed = false
+			}
+			break
+		}
+	}
+	g.rowLock.Unlock()
+
+	if Renderer(g) != nil {
+		Renderer(g).(*groupRenderer).Refresh()
+	}
+}
+
+// SetDropOnComplete sets whether a determinate row is automatically
+// removed from the group once it reaches its Max.
+func (g *ProgressGroup) SetDropOnComplete(drop bool) {
+	g.dropOnComplete = drop
+}
+
+// SetPopOnComplete sets whether a determinate row is moved to the
+// bottom of the group, below any still-running rows, once it reaches
+// its Max. Unlike SetDropOnComplete the row stays visible.
+func (g *ProgressGroup) SetPopOnComplete(pop bool) {
+	g.popOnComplete = pop
+}
+
+// Start the shared ticker that drives every row in the group.
+func (g *ProgressGroup) Start() {
+	if g.ticker == nil {
+		go g.groupLoop()
+	}
+}
+
+// Stop the shared ticker that drives every row in the group.
+func (g *ProgressGroup) Stop() {
+	if g.ticker != nil {
+		g.ticker.Stop()
+		g.ticker = nil
+	}
+}
+
+// internal loop called with `go groupLoop()`, refreshes every row once
+// per tick and funnels the resulting repaints into a single
+// canvas.Refresh call for the whole group.
+func (g *ProgressGroup) groupLoop() {
+	defer g.Stop()
+	g.ticker = time.NewTicker(groupRefreshRate)
+
+	for range g.ticker.C {
+		rows := g.snapshotRows()
+
+		for _, row := range rows {
+			if qr, ok := row.widget.(quietRefresher); ok {
+				qr.refreshQuiet()
+			}
+		}
+		g.updateAggregate(rows)
+		g.reapCompleted()
+
+		Renderer(g).(*groupRenderer).Refresh()
+	}
+}
+
+// barExtent locks bar's rateLock (shared with SetValue, which runs on a
+// worker goroutine) and returns a consistent snapshot of Value, Min and
+// Max, so a group-driven refresh never tears a bar mid-update.
+func barExtent(bar *ProgressBar) (value, min, max float64) {
+	bar.rateLock.Lock()
+	defer bar.rateLock.Unlock()
+
+	return bar.Value, bar.Min, bar.Max
+}
+
+// updateAggregate recomputes the aggregate bar as the mean completion
+// ratio across every determinate (ProgressBar) row in rows.
+func (g *ProgressGroup) updateAggregate(rows []*groupRow) {
+	total := 0.0
+	count := 0
+	for _, row := range rows {
+		if bar, ok := row.widget.(*ProgressBar); ok {
+			value, min, max := barExtent(bar)
+			if max > min {
+				total += (value - min) / (max - min)
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return
+	}
+
+	g.aggregate.Max = 1
+	g.aggregate.Value = total / float64(count)
+	g.aggregate.refreshQuiet()
+}
+
+// reapCompleted applies SetDropOnComplete/SetPopOnComplete to rows that
+// have reached their Max.
+func (g *ProgressGroup) reapCompleted() {
+	if !g.dropOnComplete && !g.popOnComplete {
+		return
+	}
+
+	g.rowLock.Lock()
+	defer g.rowLock.Unlock()
+
+	var active, completed []*groupRow
+	for _, row := range g.rows {
+		bar, ok := row.widget.(*ProgressBar)
+		if ok {
+			value, min, max := barExtent(bar)
+			if max > min && value >= max {
+				completed = append(completed, row)
+				continue
+			}
+		}
+		active = append(active, row)
+	}
+	if len(completed) == 0 {
+		return
+	}
+
+	if g.dropOnComplete {
+		g.rows = active
+	} else {
+		g.rows = append(active, completed...)
+	}
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to it's renderer
+func (g *ProgressGroup) CreateRenderer() fyne.WidgetRenderer {
+	r := &groupRenderer{group: g}
+	r.rebuild()
+	return r
+}
+
+// NewProgressGroup creates a new, empty ProgressGroup.
+func NewProgressGroup() *ProgressGroup {
+	g := &ProgressGroup{}
+	g.aggregate = NewProgressBar()
+	g.aggregate.SetDecorators(NewName("Total"), NewPercentage())
+
+	Renderer(g).Layout(g.MinSize())
+	return g
+}
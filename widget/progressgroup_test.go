@@ -0,0 +1,92 @@
+package widget
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressGroup_AddBar(t *testing.T) {
+	group := NewProgressGroup()
+	bar := group.AddBar("download", 100)
+
+	assert.Len(t, group.rows, 1)
+	assert.Equal(t, 100.0, bar.Max)
+}
+
+func TestProgressGroup_AddInfinite_IgnoresStartStop(t *testing.T) {
+	group := NewProgressGroup()
+	bar := group.AddInfinite("download")
+
+	bar.Start()
+	assert.Nil(t, bar.ticker, "a group-owned row must not spin up its own goroutine")
+
+	bar.Show()
+	assert.Nil(t, bar.ticker, "Show calls Start internally, which must still be a no-op")
+}
+
+func TestProgressGroup_Remove(t *testing.T) {
+	group := NewProgressGroup()
+	bar := group.AddBar("download", 100)
+	group.AddBar("build", 100)
+
+	group.Remove(bar)
+	assert.Len(t, group.rows, 1)
+}
+
+func TestProgressGroup_UpdateAggregate(t *testing.T) {
+	group := NewProgressGroup()
+	a := group.AddBar("a", 100)
+	b := group.AddBar("b", 100)
+
+	a.Value = 50
+	b.Value = 100
+	group.updateAggregate(group.snapshotRows())
+
+	assert.Equal(t, 0.75, group.aggregate.Value)
+}
+
+func TestProgressGroup_DropOnComplete(t *testing.T) {
+	group := NewProgressGroup()
+	group.SetDropOnComplete(true)
+	a := group.AddBar("a", 100)
+	group.AddBar("b", 100)
+	a.Value = 100
+
+	group.reapCompleted()
+	assert.Len(t, group.rows, 1)
+}
+
+func TestProgressGroup_ConcurrentAddAndSnapshot(t *testing.T) {
+	group := NewProgressGroup()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			group.AddBar("download", 100)
+		}()
+		go func() {
+			defer wg.Done()
+			group.snapshotRows()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, group.rows, 50)
+}
+
+func TestProgressGroup_PopOnComplete(t *testing.T) {
+	group := NewProgressGroup()
+	group.SetPopOnComplete(true)
+	a := group.AddBar("a", 100)
+	b := group.AddBar("b", 100)
+	a.Value = 100
+
+	group.reapCompleted()
+	assert.Len(t, group.rows, 2)
+	assert.Equal(t, a, group.rows[1].widget)
+	assert.Equal(t, b, group.rows[0].widget)
+}
@@ -0,0 +1,205 @@
+package widget
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/theme"
+)
+
+const defaultSpinnerFPS = 10
+
+// SpinnerFramesDots is a set of frames using braille dot patterns, the
+// default used by NewSpinner.
+var SpinnerFramesDots = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// SpinnerFramesASCII is a set of frames using a rotating ASCII bar,
+// for use where the braille frames in SpinnerFramesDots are not
+// available in the configured font.
+var SpinnerFramesASCII = []string{"|", "/", "-", "\\"}
+
+// SpinnerFramesEllipsis is a set of frames that grow a trailing
+// "Loading…" style ellipsis.
+var SpinnerFramesEllipsis = []string{"", ".", "..", "..."}
+
+// SpinnerFramesArc is a set of frames using a rotating quarter-arc.
+var SpinnerFramesArc = []string{"◜", "◠", "◝", "◞", "◡", "◟"}
+
+type spinnerRenderer struct {
+	objects []fyne.CanvasObject
+	text    *canvas.Text
+	spinner *Spinner
+}
+
+// MinSize calculates the minimum size of the spinner.
+func (r *spinnerRenderer) MinSize() fyne.Size {
+	size := textMinSize("100%", theme.TextSize(), fyne.TextStyle{})
+	side := fyne.Max(size.Width, size.Height) + theme.Padding()*2
+
+	return fyne.NewSize(side, side)
+}
+
+// Layout the components of the spinner widget
+func (r *spinnerRenderer) Layout(size fyne.Size) {
+	min := r.text.MinSize()
+	r.text.Move(fyne.NewPos((size.Width-min.Width)/2, (size.Height-min.Height)/2))
+	r.text.Resize(min)
+}
+
+// ApplyTheme is called when the spinner may need to update it's look
+func (r *spinnerRenderer) ApplyTheme() {
+	r.text.Color = theme.TextColor()
+
+	r.Refresh()
+}
+
+func (r *spinnerRenderer) BackgroundColor() color.Color {
+	return color.Transparent
+}
+
+// refreshQuiet recomputes the displayed frame without triggering this
+// widget's own canvas repaint; see Spinner.refreshQuiet.
+func (r *spinnerRenderer) refreshQuiet() {
+	r.text.Text = r.spinner.currentFrame()
+	r.Layout(r.spinner.Size())
+}
+
+func (r *spinnerRenderer) Refresh() {
+	r.refreshQuiet()
+	canvas.Refresh(r.spinner)
+}
+
+func (r *spinnerRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *spinnerRenderer) Destroy() {
+}
+
+// Spinner widget shows a single animated glyph that cycles through a
+// configurable set of frames, a lighter-weight alternative to
+// InfProgressBar for toolbars, inline "Loading…" text and list rows.
+type Spinner struct {
+	baseWidget
+
+	frames []string
+	frame  int
+	fps    int
+
+	ticker *time.Ticker
+}
+
+// Resize sets a new size for a widget.
+// Note this should not be used if the widget is being managed by a Layout within a Container.
+func (s *Spinner) Resize(size fyne.Size) {
+	s.resize(size, s)
+}
+
+// Move the widget to a new position, relative to it's parent.
+// Note this should not be used if the widget is being managed by a Layout within a Container.
+func (s *Spinner) Move(pos fyne.Position) {
+	s.move(pos, s)
+}
+
+// MinSize returns the smallest size this widget can shrink to
+func (s *Spinner) MinSize() fyne.Size {
+	return s.minSize(s)
+}
+
+// Show this widget, if it was previously hidden. This starts the
+// animation, mirroring InfProgressBar.
+func (s *Spinner) Show() {
+	s.Start()
+	s.show(s)
+}
+
+// Hide this widget, if it was previously visible. This stops the
+// animation, mirroring InfProgressBar.
+func (s *Spinner) Hide() {
+	s.Stop()
+	s.hide(s)
+}
+
+// SetFPS changes how many frames are shown per second. Non-positive
+// values are ignored, since they cannot be turned into a tick interval.
+// A running spinner is restarted at the new rate straight away; a
+// stopped one uses it the next time it is started.
+func (s *Spinner) SetFPS(fps int) {
+	if fps <= 0 {
+		return
+	}
+	s.fps = fps
+
+	if s.ticker != nil {
+		s.Stop()
+		s.Start()
+	}
+}
+
+// refreshQuiet advances the spinner by one frame and updates its
+// renderer without triggering its own canvas repaint. It satisfies the
+// quietRefresher interface ProgressGroup drives its rows through, but
+// ProgressGroup has no method that adds a Spinner row.
+func (s *Spinner) refreshQuiet() {
+	s.frame++
+	Renderer(s).(*spinnerRenderer).refreshQuiet()
+}
+
+// Start the spinner's background goroutine to cycle through its frames.
+func (s *Spinner) Start() {
+	if s.ticker == nil {
+		s.ticker = time.NewTicker(time.Second / time.Duration(s.fps))
+		go s.spinLoop(s.ticker)
+	}
+}
+
+// Stop the spinner's background goroutine, leaving the last frame shown.
+func (s *Spinner) Stop() {
+	if s.ticker != nil {
+		s.ticker.Stop()
+		s.ticker = nil
+	}
+}
+
+// currentFrame returns the frame to display for the spinner's current position.
+func (s *Spinner) currentFrame() string {
+	return s.frames[s.frame%len(s.frames)]
+}
+
+// internal loop called with `go spinLoop(ticker)` by Start, advances the
+// frame index once per tick of ticker until Stop is called.
+func (s *Spinner) spinLoop(ticker *time.Ticker) {
+	for range ticker.C {
+		s.frame++
+		Renderer(s).Refresh()
+	}
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to it's renderer
+func (s *Spinner) CreateRenderer() fyne.WidgetRenderer {
+	text := canvas.NewText(s.currentFrame(), theme.TextColor())
+
+	return &spinnerRenderer{objects: []fyne.CanvasObject{text}, text: text, spinner: s}
+}
+
+// NewSpinner creates a new spinner widget using the default braille
+// dot frames in SpinnerFramesDots.
+func NewSpinner() *Spinner {
+	return NewSpinnerWithFrames(SpinnerFramesDots)
+}
+
+// NewSpinnerWithFrames creates a new spinner widget that cycles through
+// the given frames, such as SpinnerFramesASCII or SpinnerFramesArc. An
+// empty frame set falls back to SpinnerFramesDots, since a spinner has
+// nothing to display (or cycle through) otherwise.
+func NewSpinnerWithFrames(frames []string) *Spinner {
+	if len(frames) == 0 {
+		frames = SpinnerFramesDots
+	}
+
+	s := &Spinner{frames: frames, fps: defaultSpinnerFPS}
+	Renderer(s).Layout(s.MinSize())
+	return s
+}
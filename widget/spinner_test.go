@@ -0,0 +1,64 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpinner_Creation(t *testing.T) {
+	spinner := NewSpinner()
+	// ticker should be nil when created
+	assert.Nil(t, spinner.ticker)
+}
+
+func TestSpinner_Ticker(t *testing.T) {
+	spinner := NewSpinner()
+
+	spinner.Show()
+	// Show() starts a goroutine, so pause for it to initialize
+	time.Sleep(10 * time.Millisecond)
+	assert.NotNil(t, spinner.ticker)
+	spinner.Hide()
+	assert.Nil(t, spinner.ticker)
+}
+
+func TestSpinner_WithFrames(t *testing.T) {
+	spinner := NewSpinnerWithFrames(SpinnerFramesASCII)
+	assert.Equal(t, "|", spinner.currentFrame())
+
+	spinner.frame = 1
+	assert.Equal(t, "/", spinner.currentFrame())
+
+	// wraps around at the end of the frame set
+	spinner.frame = len(SpinnerFramesASCII)
+	assert.Equal(t, "|", spinner.currentFrame())
+}
+
+func TestSpinner_SetFPS(t *testing.T) {
+	spinner := NewSpinner()
+	spinner.Show()
+	time.Sleep(10 * time.Millisecond)
+
+	spinner.SetFPS(30)
+	assert.Equal(t, 30, spinner.fps)
+	assert.NotNil(t, spinner.ticker)
+
+	spinner.Hide()
+}
+
+func TestSpinner_SetFPS_IgnoresNonPositive(t *testing.T) {
+	spinner := NewSpinner()
+
+	spinner.SetFPS(0)
+	assert.Equal(t, defaultSpinnerFPS, spinner.fps)
+
+	spinner.SetFPS(-5)
+	assert.Equal(t, defaultSpinnerFPS, spinner.fps)
+}
+
+func TestSpinner_WithFrames_EmptyFallsBackToDots(t *testing.T) {
+	spinner := NewSpinnerWithFrames(nil)
+	assert.Equal(t, SpinnerFramesDots[0], spinner.currentFrame())
+}